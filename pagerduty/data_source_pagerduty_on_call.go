@@ -0,0 +1,191 @@
+package pagerduty
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyOnCall() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyOnCallRead,
+
+		Schema: map[string]*schema.Schema{
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"escalation_policy_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"since": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"until": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"earliest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"oncalls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"escalation_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"escalation_level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyOnCallRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty on-calls")
+
+	o := &pagerduty.ListOnCallOptions{
+		TimeZone:            d.Get("time_zone").(string),
+		Includes:            expandStringList(d.Get("include").([]interface{})),
+		UserIds:             expandStringList(d.Get("user_ids").([]interface{})),
+		EscalationPolicyIds: expandStringList(d.Get("escalation_policy_ids").([]interface{})),
+		ScheduleIds:         expandStringList(d.Get("schedule_ids").([]interface{})),
+		Since:               d.Get("since").(string),
+		Until:               d.Get("until").(string),
+		Earliest:            d.Get("earliest").(bool),
+	}
+
+	id := fmt.Sprintf("%d", hashStringer(o))
+	var onCalls []*pagerduty.OnCall
+
+	for {
+		more := false
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			resp, _, err := client.OnCall.List(o)
+			if err != nil {
+				if isErrCode(err, 429) {
+					// Delaying retry by 30s as recommended by PagerDuty
+					// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+					time.Sleep(30 * time.Second)
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			onCalls = append(onCalls, resp.Oncalls...)
+			more = resp.More
+			o.Offset += len(resp.Oncalls)
+
+			return nil
+		})
+
+		if retryErr != nil {
+			return retryErr
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	d.SetId(id)
+	d.Set("oncalls", flattenOnCalls(onCalls))
+
+	return nil
+}
+
+func flattenOnCalls(onCalls []*pagerduty.OnCall) []map[string]interface{} {
+	var result []map[string]interface{}
+
+	for _, oc := range onCalls {
+		onCall := map[string]interface{}{
+			"escalation_level": oc.EscalationLevel,
+			"start":            stringValue(oc.Start),
+			"end":              stringValue(oc.End),
+		}
+
+		if oc.User != nil {
+			onCall["user"] = oc.User.ID
+		}
+
+		if oc.Schedule != nil {
+			onCall["schedule"] = oc.Schedule.ID
+		}
+
+		if oc.EscalationPolicy != nil {
+			onCall["escalation_policy"] = oc.EscalationPolicy.ID
+		}
+
+		result = append(result, onCall)
+	}
+
+	return result
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// hashStringer hashes the string representation of v into a stable,
+// SDK-independent id for data sources keyed on a set of filters.
+func hashStringer(v interface{}) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(fmt.Sprintf("%v", v)))
+
+	return h.Sum32()
+}
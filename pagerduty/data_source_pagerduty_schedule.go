@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,8 +17,40 @@ func dataSourcePagerDutySchedule() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
@@ -29,17 +62,101 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Reading PagerDuty schedule")
 
 	searchName := d.Get("name").(string)
+	searchNameRegex := d.Get("name_regex").(string)
+
+	if searchName == "" && searchNameRegex == "" {
+		return fmt.Errorf("one of name or name_regex must be set")
+	}
+
+	var nameRegex *regexp.Regexp
+	if searchNameRegex != "" {
+		r, err := regexp.Compile(searchNameRegex)
+		if err != nil {
+			return err
+		}
+		nameRegex = r
+	}
+
+	teamIDs := expandStringList(d.Get("teams").([]interface{}))
 
 	o := &pagerduty.ListSchedulesOptions{
 		Query: searchName,
 	}
 
+	var found []*pagerduty.Schedule
+
+	for {
+		more := false
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			resp, _, err := client.Schedules.List(o)
+			if err != nil {
+				if isErrCode(err, 429) {
+					// Delaying retry by 30s as recommended by PagerDuty
+					// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+					time.Sleep(30 * time.Second)
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			for _, schedule := range resp.Schedules {
+				if nameRegex != nil {
+					if !nameRegex.MatchString(schedule.Name) {
+						continue
+					}
+				} else if schedule.Name != searchName {
+					continue
+				}
+
+				if !scheduleMatchesTeams(schedule, teamIDs) {
+					continue
+				}
+
+				found = append(found, schedule)
+			}
+
+			more = resp.More
+			o.Offset += len(resp.Schedules)
+
+			return nil
+		})
+
+		if retryErr != nil {
+			return retryErr
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if len(found) == 0 {
+		if searchNameRegex != "" {
+			return fmt.Errorf("Unable to locate any schedule matching the name_regex: %s", searchNameRegex)
+		}
+
+		return fmt.Errorf("Unable to locate any schedule with the name: %s", searchName)
+	}
+
+	if len(found) > 1 && !d.Get("most_recent").(bool) {
+		names := make([]string, len(found))
+		for i, s := range found {
+			names[i] = s.Name
+		}
+
+		return fmt.Errorf("your query returned more than one result, candidates are: %v. Use `most_recent` to pick one", names)
+	}
+
+	// The vendored SDK's schedule representation carries no recency field to
+	// sort by, so most_recent just takes the first match in API list order.
+	schedule := found[0]
+
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.Schedules.List(o)
+		full, _, err := client.Schedules.Get(schedule.ID, &pagerduty.GetScheduleOptions{})
 		if err != nil {
 			if isErrCode(err, 429) {
-				// Delaying retry by 30s as recommended by PagerDuty
-				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
 				time.Sleep(30 * time.Second)
 				return resource.RetryableError(err)
 			}
@@ -47,24 +164,34 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 			return resource.NonRetryableError(err)
 		}
 
-		var found *pagerduty.Schedule
-
-		for _, schedule := range resp.Schedules {
-			if schedule.Name == searchName {
-				found = schedule
-				break
-			}
-		}
+		d.SetId(full.ID)
+		d.Set("name", full.Name)
+		d.Set("time_zone", full.TimeZone)
+		d.Set("description", full.Description)
+		d.Set("html_url", full.HTMLURL)
 
-		if found == nil {
-			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any schedule with the name: %s", searchName),
-			)
+		users := make([]string, 0, len(full.Users))
+		for _, user := range full.Users {
+			users = append(users, user.ID)
 		}
-
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
+		d.Set("users", users)
 
 		return nil
 	})
 }
+
+func scheduleMatchesTeams(schedule *pagerduty.Schedule, teamIDs []string) bool {
+	if len(teamIDs) == 0 {
+		return true
+	}
+
+	for _, team := range schedule.Teams {
+		for _, id := range teamIDs {
+			if team.ID == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
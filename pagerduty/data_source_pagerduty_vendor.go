@@ -0,0 +1,137 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyVendor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyVendorRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) error {
+	client, _ := meta.(*Config).Client()
+
+	log.Printf("[INFO] Reading PagerDuty vendor")
+
+	searchName := d.Get("name").(string)
+	searchNameRegex := d.Get("name_regex").(string)
+
+	if searchName == "" && searchNameRegex == "" {
+		return fmt.Errorf("one of name or name_regex must be set")
+	}
+
+	match, err := findVendorByName(client, searchName, searchNameRegex, d.Get("most_recent").(bool))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(match.ID)
+	d.Set("name", match.Name)
+	d.Set("type", match.Type)
+
+	return nil
+}
+
+// findVendorByName pages through /vendors and returns the single vendor
+// matching name (exact match) or nameRegex. If more than one vendor matches,
+// mostRecent picks the first candidate instead of erroring.
+func findVendorByName(client *pagerduty.Client, name, nameRegexStr string, mostRecent bool) (*pagerduty.Vendor, error) {
+	var nameRegex *regexp.Regexp
+	if nameRegexStr != "" {
+		r, err := regexp.Compile(nameRegexStr)
+		if err != nil {
+			return nil, err
+		}
+		nameRegex = r
+	}
+
+	o := &pagerduty.ListVendorsOptions{}
+
+	var found []*pagerduty.Vendor
+
+	for {
+		more := false
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			resp, _, err := client.Vendors.List(o)
+			if err != nil {
+				if isErrCode(err, 429) {
+					// Delaying retry by 30s as recommended by PagerDuty
+					// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+					time.Sleep(30 * time.Second)
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+
+			for _, vendor := range resp.Vendors {
+				if nameRegex != nil {
+					if nameRegex.MatchString(vendor.Name) {
+						found = append(found, vendor)
+					}
+				} else if vendor.Name == name {
+					found = append(found, vendor)
+				}
+			}
+
+			more = resp.More
+			o.Offset += len(resp.Vendors)
+
+			return nil
+		})
+
+		if retryErr != nil {
+			return nil, retryErr
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("unable to locate any vendor matching the provided name or name_regex")
+	}
+
+	if len(found) > 1 && !mostRecent {
+		names := make([]string, len(found))
+		for i, vendor := range found {
+			names[i] = vendor.Name
+		}
+
+		return nil, fmt.Errorf("your query returned more than one result, candidates are: %v. Use `most_recent` to pick one", names)
+	}
+
+	return found[0], nil
+}
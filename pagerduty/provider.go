@@ -0,0 +1,26 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"pagerduty_addon":               resourcePagerDutyAddon(),
+			"pagerduty_service_integration": resourcePagerDutyServiceIntegration(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"pagerduty_schedule": dataSourcePagerDutySchedule(),
+			"pagerduty_on_call":  dataSourcePagerDutyOnCall(),
+			"pagerduty_vendor":   dataSourcePagerDutyVendor(),
+		},
+		ConfigureFunc: providerConfigure,
+	}
+}
@@ -27,6 +27,15 @@ func resourcePagerDutyAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "full_page_addon",
+				ValidateFunc: validateValueFunc([]string{
+					"full_page_addon",
+					"incident_show_addon",
+				}),
+			},
 		},
 	}
 }
@@ -35,7 +44,7 @@ func buildAddonStruct(d *schema.ResourceData) *pagerduty.Addon {
 	addon := &pagerduty.Addon{
 		Name: d.Get("name").(string),
 		Src:  d.Get("src").(string),
-		Type: "full_page_addon",
+		Type: d.Get("type").(string),
 	}
 
 	return addon
@@ -58,6 +67,7 @@ func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback f
 
 		d.Set("name", addon.Name)
 		d.Set("src", addon.Src)
+		d.Set("type", addon.Type)
 
 		return nil
 	})
@@ -69,6 +69,12 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				ConflictsWith: []string{"type"},
 				Computed:      true,
 			},
+			"vendor_name": {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"type", "vendor"},
+			},
 			"integration_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -87,7 +93,7 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 	}
 }
 
-func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
+func buildServiceIntegrationStruct(d *schema.ResourceData, meta interface{}) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
 		Type: "service_integration",
@@ -116,6 +122,20 @@ func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integrati
 		}
 	}
 
+	if attr, ok := d.GetOk("vendor_name"); ok {
+		client, _ := meta.(*Config).Client()
+
+		vendor, err := findVendorByName(client, attr.(string), "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceIntegration.Vendor = &pagerduty.VendorReference{
+			ID:   vendor.ID,
+			Type: "vendor",
+		}
+	}
+
 	if serviceIntegration.Type == "generic_email_inbound_integration" && serviceIntegration.IntegrationEmail == "" {
 		return nil, errors.New(errEmailIntegrationMustHaveEmail)
 	}
@@ -172,7 +192,7 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
-	serviceIntegration, err := buildServiceIntegrationStruct(d)
+	serviceIntegration, err := buildServiceIntegrationStruct(d, meta)
 	if err != nil {
 		return err
 	}
@@ -210,7 +230,7 @@ func resourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta interf
 func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, _ := meta.(*Config).Client()
 
-	serviceIntegration, err := buildServiceIntegrationStruct(d)
+	serviceIntegration, err := buildServiceIntegrationStruct(d, meta)
 	if err != nil {
 		return err
 	}